@@ -4,70 +4,231 @@ package GLA
 
 import (
 	"fmt"
+	"runtime"
 	"sync"
 )
 
-// This function computes the dot product of two vectors
+// Mb, Nb, and Kb are the cache-blocking tile sizes Matmul/MatmulInto use along the m, n, and k
+// dimensions of the matrix product. They are package vars rather than constants so callers can tune
+// them to their machine's cache sizes.
+var (
+	Mb = 64
+	Nb = 64
+	Kb = 256
+)
+
+// Matmul computes the matrix product of A and B. Following numpy/PyTorch batched-matmul semantics,
+// the last two dimensions of each input are treated as the matrix dimensions (A.shape = [..., m, k],
+// B.shape = [..., k, n]) and any leading "batch" dimensions are broadcast against each other, giving
+// C.shape = [...broadcast batch..., m, n]. This is a thin wrapper that allocates C and dispatches to
+// MatmulInto; use MatmulInto directly to reuse a preallocated output in hot loops.
 func Matmul(A *Tensor, B *Tensor) *Tensor {
+	C := Zero_Tensor(matmulOutputShape(A, B))
+	MatmulInto(A, B, C)
+	return C
+}
 
-	// check if tensor shapes are compatible for matmul
-	if len(A.shape) != 2 || len(B.shape) != 2 {
-		panic("Within Matmul(): Tensors must both be 2D to compute matmul")
+// MatmulInto computes the batched matrix product of A and B into the preallocated tensor C, avoiding
+// the allocation that Matmul incurs. C must already have the shape matmulOutputShape(A, B) would
+// produce.
+func MatmulInto(A *Tensor, B *Tensor, C *Tensor) {
+	m, k := matDims(A.shape)
+	k2, n := matDims(B.shape)
+	if k != k2 {
+		panic("Within Matmul(): Tensors must be compatible for matmul")
 	}
 
-	// check if mxn and nxp
-	if A.shape[1] != B.shape[0] {
-		panic("Within Matmul(): 2D Tensors must be compatible for matmul")
+	aBatch, bBatch, cBatch := batchDims(A.shape), batchDims(B.shape), batchDims(C.shape)
+	if !shapeEquals(cBatch, broadcastBatchShapes(aBatch, bBatch)) || C.shape[len(C.shape)-2] != m || C.shape[len(C.shape)-1] != n {
+		panic("Within Matmul(): C does not have the shape required for this matmul")
 	}
 
-	C := Zero_Tensor([]int{A.shape[0], B.shape[1]}) // <-- returns pointer to Tensor struct
+	// computeBatchBlock accumulates into C.data with +=, so a reused C must start from zero.
+	for i := range C.data {
+		C.data[i] = 0
+	}
 
-	numGoroutines := 4
-	chunkSize := C.shape[0] / numGoroutines
+	numBatches := 1
+	for _, d := range cBatch {
+		numBatches *= d
+	}
+	mBlocks := (m + Mb - 1) / Mb
 
-	// because each index of C is indepentent of the other, we will write directly to the
-	// C.data slice within the C tensor, and there is no need for a mutex.
+	// Parallelize over batch x m-block instead of a fixed 4 goroutines, so the work scales with the
+	// machine and never produces a zero chunk size when C.shape[0] is smaller than numGoroutines.
+	totalTasks := numBatches * mBlocks
+	numWorkers := runtime.NumCPU()
+	if numWorkers > totalTasks {
+		numWorkers = totalTasks
+	}
+
+	tasks := make(chan int, totalTasks)
+	for t := 0; t < totalTasks; t++ {
+		tasks <- t
+	}
+	close(tasks)
 
 	var wg sync.WaitGroup
+	for w := 0; w < numWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range tasks {
+				batchIdx, mBlockIdx := task/mBlocks, task%mBlocks
+				computeBatchBlock(A, B, C, batchIdx, aBatch, bBatch, cBatch, mBlockIdx, m, n, k)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// computeBatchBlock is a helper function for MatmulInto() above. It computes one m-block of one
+// batch slice of the output, using a cache-blocked Mb x Nb x Kb kernel: for each Kb-wide panel it
+// packs A's [mBlock x Kb] block into a contiguous scratch slice once, eliminating the repeated
+// strided Index([]int{row,k}, A.shape) reads the naive 2D loop used to perform for every column.
+func computeBatchBlock(A, B, C *Tensor, batchIdx int, aBatch, bBatch, cBatch []int, mBlockIdx, m, n, k int) {
+	cBatchIdx := UnravelIndex(batchIdx, cBatch)
+	aOffset := broadcastBatchOffset(cBatchIdx, cBatch, aBatch) * m * k
+	bOffset := broadcastBatchOffset(cBatchIdx, cBatch, bBatch) * k * n
+	cOffset := batchIdx * m * n
 
-	for i := 0; i < numGoroutines; i++ {
+	mStart := mBlockIdx * Mb
+	mEnd := mStart + Mb
+	if mEnd > m {
+		mEnd = m
+	}
+	blockRows := mEnd - mStart
 
-		wg.Add(1) // Increment the WaitGroup counter
+	packedA := make([]float64, blockRows*Kb)
 
-		start := i * chunkSize //  compute bounds of the chunk
-		end := start + chunkSize
+	for kStart := 0; kStart < k; kStart += Kb {
+		kEnd := kStart + Kb
+		if kEnd > k {
+			kEnd = k
+		}
+		blockCols := kEnd - kStart
 
-		if i == numGoroutines-1 {
-			end = C.shape[0] // Ensure the last chunk includes any remaining elements
+		// pack A's [mStart:mEnd, kStart:kEnd] block into contiguous scratch before the inner loop
+		for i := 0; i < blockRows; i++ {
+			srcStart := aOffset + (mStart+i)*k + kStart
+			copy(packedA[i*blockCols:(i+1)*blockCols], A.data[srcStart:srcStart+blockCols])
 		}
 
-		go computeRow(A, B, C, start, end, &wg)
+		for nStart := 0; nStart < n; nStart += Nb {
+			nEnd := nStart + Nb
+			if nEnd > n {
+				nEnd = n
+			}
+
+			for row := mStart; row < mEnd; row++ {
+				packedRow := packedA[(row-mStart)*blockCols : (row-mStart+1)*blockCols]
+				cRowOffset := cOffset + row*n
+
+				for col := nStart; col < nEnd; col++ {
+					var sum float64
+					bColStart := bOffset + kStart*n + col
+					for kk := 0; kk < blockCols; kk++ {
+						sum += packedRow[kk] * B.data[bColStart+kk*n]
+					}
+					C.data[cRowOffset+col] += sum
+				}
+			}
+		}
 	}
-	return C
 }
 
-// This is a helper function for Matmul() above. It computes the dot product of a chunk of the vectors
-func computeRow(A *Tensor, B *Tensor, C *Tensor, start int, end int, wg *sync.WaitGroup) {
-	defer wg.Done()
+// matDims returns the (m, k) matrix dimensions, i.e. the last two entries, of a Matmul operand's shape.
+func matDims(shape []int) (int, int) {
+	if len(shape) < 2 {
+		panic("Within Matmul(): Tensors must both be at least 2D to compute matmul")
+	}
+	return shape[len(shape)-2], shape[len(shape)-1]
+}
 
-	for row := start; row < end; row++ { // <-- iterate through rows of C
+// batchDims returns the leading "batch" dimensions of a Matmul operand's shape, i.e. every dimension
+// except the trailing matrix dimensions.
+func batchDims(shape []int) []int {
+	return shape[:len(shape)-2]
+}
 
-		for col := 0; col < C.shape[1]; col++ { // <-- iterate through columns of C
+// matmulOutputShape computes the shape Matmul(A, B) will produce: the broadcast of A and B's batch
+// dimensions, followed by the m and n matrix dimensions.
+func matmulOutputShape(A, B *Tensor) []int {
+	m, k := matDims(A.shape)
+	k2, n := matDims(B.shape)
+	if k != k2 {
+		panic("Within Matmul(): Tensors must be compatible for matmul")
+	}
 
-			var sum float64
-			for k := 0; k < A.shape[1]; k++ { // compute dot product of row of A and column of B
-				A_idx := Index([]int{row, k}, A.shape)
-				B_idx := Index([]int{k, col}, B.shape)
+	batchShape := broadcastBatchShapes(batchDims(A.shape), batchDims(B.shape))
+	outShape := append(append([]int{}, batchShape...), m, n)
+	return outShape
+}
 
-				sum += A.data[A_idx] * B.data[B_idx]
-			}
-			// compute flat index of C
-			C_idx := Index([]int{row, col}, C.shape)
+// broadcastBatchShapes broadcasts two batch-dimension shapes together following numpy semantics:
+// shapes are aligned from the right, and in each dimension either the sizes must match or one of
+// them must be 1.
+func broadcastBatchShapes(a, b []int) []int {
+	rank := len(a)
+	if len(b) > rank {
+		rank = len(b)
+	}
+
+	out := make([]int, rank)
+	for i := 0; i < rank; i++ {
+		ai, bi := 1, 1
+		if i < len(a) {
+			ai = a[len(a)-1-i]
+		}
+		if i < len(b) {
+			bi = b[len(b)-1-i]
+		}
+
+		switch {
+		case ai == bi:
+			out[rank-1-i] = ai
+		case ai == 1:
+			out[rank-1-i] = bi
+		case bi == 1:
+			out[rank-1-i] = ai
+		default:
+			panic("Within Matmul(): batch dimensions are not broadcastable")
+		}
+	}
+	return out
+}
 
-			// write to C.data slice directly
-			C.data[C_idx] = sum
+// broadcastBatchOffset maps a multi-index into the broadcast batch shape to the flattened batch
+// index of an operand with its own (possibly shorter, possibly size-1) batch shape, per numpy
+// broadcasting rules: a missing leading dimension or a size-1 dimension always resolves to index 0.
+func broadcastBatchOffset(outIdx []int, outShape []int, ownShape []int) int {
+	if len(ownShape) == 0 {
+		return 0
+	}
+
+	rankDiff := len(outShape) - len(ownShape)
+	flat := 0
+	for i, size := range ownShape {
+		idx := outIdx[i+rankDiff]
+		if size == 1 {
+			idx = 0
 		}
+		flat = flat*size + idx
 	}
+	return flat
+}
+
+// shapeEquals reports whether two shapes are identical.
+func shapeEquals(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 // this function is used to display a 2D tensor as a matrix
@@ -107,4 +268,4 @@ func Augment_Matrix(A *Tensor, B *Tensor) *Tensor {
 	}
 
 	return A.Concat(B, 1) // <--- return the concatenation of the two Tensors along the 1'th axis
-}
\ No newline at end of file
+}
@@ -8,40 +8,113 @@ import (
 	"sync"
 )
 
-// The Partial function is used to retrieve a section out of a Tensor using Python-like slice notation.
-// It accepts a Tensor and a string, then returns a pointer to a new tensor.
-// Example:
-// A := Range_Tensor([]int{3, 4, 9, 2})
-// A_Partial := Partial(A, "0:2, 2:, :3, :")
-func Partial(A *Tensor, slice string) *Tensor {
-	// Remove spaces and split the slice string by commas to handle each dimension separately.
+// sliceTriple holds the parsed (start, stop, step) for one dimension of a Python/NumPy-like slice
+// string, plus the resulting extent (number of elements the slice walks along that dimension) and
+// whether the dimension was given as a single bare index (e.g. "2") rather than a range.
+type sliceTriple struct {
+	start, stop, step, extent int
+	isIndex                   bool
+}
+
+// ParseSliceTriples parses a Partial/Partial_Assign slice string against shape and returns the
+// (start, stop, step) triple for every dimension, exposed mainly so the parser can be exercised
+// directly. It supports the full NumPy/TensorFlow strided_slice grammar: ":", "start:", ":end",
+// "start:end" and "start:stop:step", with negative start/stop counting back from shape[dim] and a
+// negative step defaulting start to shape[dim]-1 and stop to -1 (i.e. "::-1" reverses the dimension).
+// A dimension may also be given as a single bare index, e.g. "2", which parses as "2:3".
+func ParseSliceTriples(slice string, shape []int) ([][3]int, []int) {
+	triples, extents, _ := parseSliceSpec(slice, shape)
+	return triples, extents
+}
+
+// parseSliceSpec is the shared implementation behind ParseSliceTriples and PartialReduce. Besides
+// the triples and extents it also reports, per dimension, whether the spec was a single bare index
+// rather than a range, which PartialReduce uses to decide which dimensions to squeeze away.
+func parseSliceSpec(slice string, shape []int) ([][3]int, []int, []bool) {
 	slice = strings.ReplaceAll(slice, " ", "")
 	split := strings.Split(slice, ",")
-	if len(split) != len(A.shape) {
+	if len(split) != len(shape) {
 		panic("String slice arg must have the same number of dimensions as the tensor")
 	}
 
-	// Initialize slices to store the shape of the partial tensor and the start/end indices for each dimension.
-	partialShape := make([]int, len(A.shape))
-	partialIndices := make([][]int, len(A.shape))
-
-	// Iterate through each dimension of the tensor to parse the slice string and compute the shape and indices of the partial tensor.
+	triples := make([][3]int, len(shape))
+	extents := make([]int, len(shape))
+	isIndex := make([]bool, len(shape))
 	for i, s := range split {
-		start, end := 0, A.shape[i] // By default, use the entire dimension.
-		if s != ":" {
-			parts := strings.Split(s, ":")
+		t := parseSliceDim(s, shape[i])
+		triples[i] = [3]int{t.start, t.stop, t.step}
+		extents[i] = t.extent
+		isIndex[i] = t.isIndex
+	}
+	return triples, extents, isIndex
+}
 
-			if parts[0] != "" { // If there is a start value, update start.
-				start, _ = strconv.Atoi(parts[0])
-			}
-			if parts[1] != "" { // If there is an end value, update end.
-				end, _ = strconv.Atoi(parts[1])
-			}
+// parseSliceDim parses a single dimension's slice spec (e.g. "1:9:2", "::-1", ":", "2:", "2") against
+// dimSize and returns the resolved start/stop/step plus the number of elements the slice covers. A
+// spec with no ":" at all, like "2", is treated as a single integer index equivalent to "2:3".
+func parseSliceDim(s string, dimSize int) sliceTriple {
+	if !strings.Contains(s, ":") {
+		idx, _ := strconv.Atoi(s)
+		if idx < 0 {
+			idx += dimSize
 		}
-		partialShape[i] = end - start
-		partialIndices[i] = []int{start, end}
+		return sliceTriple{start: idx, stop: idx + 1, step: 1, extent: 1, isIndex: true}
+	}
+
+	parts := strings.SplitN(s, ":", 3)
+	for len(parts) < 3 {
+		parts = append(parts, "")
+	}
+
+	step := 1
+	if parts[2] != "" {
+		step, _ = strconv.Atoi(parts[2])
+	}
+	if step == 0 {
+		panic("Slice step cannot be 0")
 	}
 
+	// Defaults depend on the sign of step: a negative step walks the dimension backwards.
+	start, stop := 0, dimSize
+	if step < 0 {
+		start, stop = dimSize-1, -1
+	}
+
+	if parts[0] != "" {
+		start, _ = strconv.Atoi(parts[0])
+		if start < 0 {
+			start += dimSize
+		}
+	}
+	if parts[1] != "" {
+		stop, _ = strconv.Atoi(parts[1])
+		if stop < 0 {
+			stop += dimSize
+		}
+	}
+
+	// extent is the number of elements visited, i.e. ceil((stop-start)/step), clamped to 0.
+	extent := 0
+	if step > 0 && stop > start {
+		extent = (stop - start + step - 1) / step
+	} else if step < 0 && stop < start {
+		negStep := -step
+		extent = (start - stop + negStep - 1) / negStep
+	}
+
+	return sliceTriple{start: start, stop: stop, step: step, extent: extent}
+}
+
+// The Partial function is used to retrieve a section out of a Tensor using Python-like slice notation.
+// It accepts a Tensor and a string, then returns a pointer to a new tensor. The slice string supports
+// the full NumPy-style "start:stop:step" grammar per dimension, including negative start/stop/step
+// (e.g. "::-1" reverses a dimension, "1:9:2" takes every other element from index 1 up to 9).
+// Example:
+// A := Range_Tensor([]int{3, 4, 9, 2})
+// A_Partial := Partial(A, "0:2, 2:, :3, :")
+func Partial(A *Tensor, slice string) *Tensor {
+	triples, partialShape := ParseSliceTriples(slice, A.shape)
+
 	// Create a new tensor to store the partial data with the computed shape.
 	partialTensor := Zero_Tensor(partialShape)
 
@@ -54,10 +127,10 @@ func Partial(A *Tensor, slice string) *Tensor {
 	fillPartialTensor = func(dim int) {
 		if dim == len(partialShape) { // <--- This base case is reached for every element in the partial tensor.
 
-			// Calculate the source index in the original tensor.
+			// Calculate the source index in the original tensor, striding by each dimension's step.
 			srcIndex := make([]int, len(partialShape))
-			for i, indices := range partialIndices {
-				srcIndex[i] = tempIndex[i] + indices[0]
+			for i, t := range triples {
+				srcIndex[i] = t[0] + tempIndex[i]*t[2]
 			}
 
 			// Convert the multi-dimensional indices to flattened indices and use them to copy the data.
@@ -82,6 +155,82 @@ func Partial(A *Tensor, slice string) *Tensor {
 	return partialTensor
 }
 
+// Partial_Assign is the write-side counterpart to Partial. It parses the same Python-like slice
+// string grammar, and returns a new tensor that is a copy of A except that the region described by
+// slice is overwritten with the contents of value. value.shape must equal the shape of the slice,
+// unless value holds a single element, in which case that element is broadcast across the whole
+// slice. Example:
+// A := Range_Tensor([]int{3, 4})
+// A_Assigned := Partial_Assign(A, "1:2, 2:", Zero_Tensor([]int{1, 2}))
+func Partial_Assign(A *Tensor, slice string, value *Tensor) *Tensor {
+	assignedTensor := &Tensor{shape: append([]int{}, A.shape...), data: append([]float64{}, A.data...)}
+	partial_assign(assignedTensor, slice, value)
+	return assignedTensor
+}
+
+// Partial_Assign_ is the in-place variant of Partial_Assign. It mutates A.data directly instead of
+// allocating a new tensor, for callers that own A and don't need the original left untouched.
+func Partial_Assign_(A *Tensor, slice string, value *Tensor) *Tensor {
+	partial_assign(A, slice, value)
+	return A
+}
+
+// partial_assign is the shared implementation behind Partial_Assign and Partial_Assign_. It parses
+// slice exactly like Partial does, then walks the same recursive multi-index, except that instead of
+// reading out of A it writes into A.data using value's elements (or a single broadcast element).
+func partial_assign(A *Tensor, slice string, value *Tensor) {
+	triples, partialShape := ParseSliceTriples(slice, A.shape)
+
+	// value must either match the shape of the slice exactly, or be a single element broadcast across it.
+	broadcast := len(value.data) == 1
+	if !broadcast {
+		if len(value.shape) != len(partialShape) {
+			panic("Partial_Assign(): value must have the same number of dimensions as the slice")
+		}
+		for i := range partialShape {
+			if value.shape[i] != partialShape[i] {
+				panic("Partial_Assign(): value.shape must equal the shape of the slice")
+			}
+		}
+	}
+
+	// Initialize a slice to store the current multi-dimensional index being processed.
+	tempIndex := make([]int, len(partialShape))
+
+	// Define a recursive function to walk the slice region and assign into A.
+	// The function takes the current dimension as a parameter.
+	var fillPartialAssign func(int)
+	fillPartialAssign = func(dim int) {
+		if dim == len(partialShape) { // <--- This base case is reached for every element in the slice region.
+
+			// Calculate the destination index in A, striding by each dimension's step.
+			dstIndex := make([]int, len(partialShape))
+			for i, t := range triples {
+				dstIndex[i] = t[0] + tempIndex[i]*t[2]
+			}
+
+			dstFlattenedIndex := Index(dstIndex, A.shape)
+			if broadcast {
+				A.data[dstFlattenedIndex] = value.data[0]
+			} else {
+				srcFlattenedIndex := Index(tempIndex, partialShape)
+				A.data[dstFlattenedIndex] = value.data[srcFlattenedIndex]
+			}
+
+			return
+		}
+
+		// Recursively process each index in the current dimension.
+		for i := 0; i < partialShape[dim]; i++ {
+			tempIndex[dim] = i
+			fillPartialAssign(dim + 1)
+		}
+	}
+
+	// Start the recursive process from the first dimension.
+	fillPartialAssign(0)
+}
+
 // Reshape()  takes a tensors and a new shape for that tensors, and returns a pointer to a
 // new tensors that has the same data as the original tensor, but with the new shape. Reshape
 // can be done in this way becauase data for Tensors in stored contigously in memory.
@@ -170,21 +319,37 @@ func (A *Tensor) Transpose(axes []int) *Tensor {
 // is the 0'th axis. They can then simply be appended together contiguously and transposed back to the
 // original ordering of dimmensions.
 func (A *Tensor) Concat(B *Tensor, axis_cat int) *Tensor {
+	return ConcatList([]*Tensor{A, B}, axis_cat)
+}
+
+// ConcatList joins any number of tensors together along axis_cat, in the order given by inputs.
+// All inputs must share the same rank and the same shape in every dimension except axis_cat, and
+// the axis_cat dimension of the result is the sum of the axis_cat dimensions of the inputs. This
+// generalizes Concat() (which only joins two tensors) to the TOSA-style variadic concat, and it
+// does so without the pairwise re-transposing that repeated calls to Concat() would incur: when
+// axis_cat != 0 every input is transposed exactly once, appended together, and transposed back once.
+func ConcatList(inputs []*Tensor, axis_cat int) *Tensor {
 
-	// Ensure that the number of dimensions of the tensors are the same
-	if len(A.shape) != len(B.shape) {
-		panic("The number of dimensions of the tensors must be the same.")
+	if len(inputs) == 0 {
+		panic("ConcatList() requires at least one tensor")
 	}
 
+	first := inputs[0]
+
 	// Check that axis_cat is within the valid range
-	if axis_cat < 0 || axis_cat >= len(A.shape) {
+	if axis_cat < 0 || axis_cat >= len(first.shape) {
 		panic("axis_cat is out of bounds for the shape of the tensors.")
 	}
 
-	// Ensure that the shape of the tensors are the same except for the axis of concatenation
-	for i := 0; i < len(A.shape); i++ {
-		if i != axis_cat && A.shape[i] != B.shape[i] {
-			panic("The shapes of the tensors must be the same except for the axis of concatenation.")
+	// Ensure that every tensor shares the same rank, and the same shape except along axis_cat
+	for _, T := range inputs[1:] {
+		if len(T.shape) != len(first.shape) {
+			panic("The number of dimensions of the tensors must be the same.")
+		}
+		for i := 0; i < len(first.shape); i++ {
+			if i != axis_cat && T.shape[i] != first.shape[i] {
+				panic("The shapes of the tensors must be the same except for the axis of concatenation.")
+			}
 		}
 	}
 
@@ -195,31 +360,33 @@ func (A *Tensor) Concat(B *Tensor, axis_cat int) *Tensor {
 	if axis_cat == 0 {
 
 		// Determine the shape of the concatenated tensor
-		concatShape := make([]int, len(A.shape))
-		for i := 0; i < len(A.shape); i++ {
-			if i == axis_cat {
-				concatShape[i] = A.shape[i] + B.shape[i] // <--- concatenation extends this dimension
-			} else {
-				concatShape[i] = A.shape[i]
-			}
+		concatShape := make([]int, len(first.shape))
+		copy(concatShape, first.shape)
+		concatShape[axis_cat] = 0
+		for _, T := range inputs {
+			concatShape[axis_cat] += T.shape[axis_cat]
 		}
 
-		// concatenate data contiguously into new slice
-		concatData := append(A.data, B.data...)
+		// concatenate data contiguously into new slice, walking the inputs in order
+		concatData := make([]float64, 0, len(first.data)*len(inputs))
+		for _, T := range inputs {
+			concatData = append(concatData, T.data...)
+		}
 
 		// create new tensor to store concatenated data for return
 		concatTensor = &Tensor{shape: concatShape, data: concatData}
-	} else if axis_cat != 0 {
+	} else {
 
 		// determine the reordering of the axes for transpose to make axis_cat the 0'th axis the slice
-		// will be a permutation of the numbers 0 through len(A.shape) - 1 with axis cat and 0 swapped
-		axes_reordering := make([]int, len(A.shape))
+		// will be a permutation of the numbers 0 through len(first.shape) - 1 with axis_cat moved to the
+		// front and the remaining axes kept in their original order
+		axes_reordering := make([]int, len(first.shape))
 
 		// set axis cat to 0'th axis
 		axes_reordering[0] = axis_cat
 
 		// Now fill in the rest of the axes.
-		for i, count := 1, 0; count < len(A.shape); count++ {
+		for i, count := 1, 0; count < len(first.shape); count++ {
 			// exclude axis_cat from the reordering, its already at 0
 			if count != axis_cat {
 				axes_reordering[i] = count
@@ -227,31 +394,42 @@ func (A *Tensor) Concat(B *Tensor, axis_cat int) *Tensor {
 			}
 		}
 
-		// transpose A and B to make axis_cat the 0'th axis
-		A_T := A.Transpose(axes_reordering)
-		B_T := B.Transpose(axes_reordering)
+		// Invert axes_reordering so the concatenated result can be transposed back to the original
+		// axis ordering; axes_reordering is only its own inverse when axis_cat <= 1, so for axis_cat >= 2
+		// on rank >= 3 tensors it must be inverted properly rather than reused as-is.
+		inverse_reordering := make([]int, len(axes_reordering))
+		for i, a := range axes_reordering {
+			inverse_reordering[a] = i
+		}
 
-		// concatenate data contiguously into new slice
-		concatData_Transposed := append(A_T.data, B_T.data...)
+		// transpose every input once to make axis_cat the 0'th axis
+		transposed := make([]*Tensor, len(inputs))
+		for i, T := range inputs {
+			transposed[i] = T.Transpose(axes_reordering)
+		}
 
-		// We now have a slice of contigous data that is the concatenation of A_T and B_T, in order to use
-		// this data to create a new tensor, we must first determine the shape of the new tensor in this
-		// Trasnposed form. This can be done by copying A_T.shape and adding B_T.shape[0] to it.
-		concatShape_Transposed := make([]int, len(A_T.shape))
-		for i := 0; i < len(A_T.shape); i++ {
-			if i == 0 {
-				concatShape_Transposed[i] = A_T.shape[i] + B_T.shape[i]
-			} else {
-				concatShape_Transposed[i] = A_T.shape[i]
-			}
+		// concatenate all of the transposed data contiguously into new slice, in order
+		concatData_Transposed := make([]float64, 0, len(transposed[0].data)*len(transposed))
+		for _, T := range transposed {
+			concatData_Transposed = append(concatData_Transposed, T.data...)
+		}
+
+		// We now have a slice of contigous data that is the concatenation of the transposed inputs, in order
+		// to use this data to create a new tensor, we must first determine the shape of the new tensor in this
+		// Trasnposed form. This can be done by copying the first transposed shape and summing the 0'th axes.
+		concatShape_Transposed := make([]int, len(transposed[0].shape))
+		copy(concatShape_Transposed, transposed[0].shape)
+		concatShape_Transposed[0] = 0
+		for _, T := range transposed {
+			concatShape_Transposed[0] += T.shape[0]
 		}
 
 		// create new tensor to store the transposed concatenated data
 		concatTensor_Transposed := &Tensor{shape: concatShape_Transposed, data: concatData_Transposed}
 
-		// transpose the concatenated tensor back to the original ordering of axes. Because we only swapped
-		// two axes, we can just reuse the same axe_reordering array from the originbal transpose.
-		concatTensor = concatTensor_Transposed.Transpose(axes_reordering)
+		// transpose the concatenated tensor back to the original ordering of axes using the inverse
+		// of axes_reordering.
+		concatTensor = concatTensor_Transposed.Transpose(inverse_reordering)
 	}
 
 	return concatTensor
@@ -316,3 +494,354 @@ func (A *Tensor) Extend(num_elements int) *Tensor {
 	// Return the filled extended tensor
 	return extendedTensor
 }
+
+// Stack joins a list of equally-shaped tensors along a new dimension inserted at axis, analogous to
+// TensorFlow's pack. All inputs must share rank r and shape; axis must be in [0, r]; the result has
+// rank r+1 with a dimension of size len(tensors) at axis, where element (i0,...,i_{r-1}) of
+// tensors[k] lands at output index (i0,...,i_{axis-1}, k, i_axis,...). It is implemented by reshaping
+// each input to insert a size-1 dimension at axis, then concatenating the reshaped inputs along axis,
+// reusing ConcatList's single transpose-to-axis-0 trick instead of stacking pairwise.
+func Stack(tensors []*Tensor, axis int) *Tensor {
+	if len(tensors) == 0 {
+		panic("Stack() requires at least one tensor")
+	}
+
+	first := tensors[0]
+	rank := len(first.shape)
+	if axis < 0 || axis > rank {
+		panic("axis is out of bounds for Stack()")
+	}
+
+	for _, T := range tensors[1:] {
+		if len(T.shape) != rank {
+			panic("All tensors passed to Stack() must have the same rank")
+		}
+		for i := 0; i < rank; i++ {
+			if T.shape[i] != first.shape[i] {
+				panic("All tensors passed to Stack() must have the same shape")
+			}
+		}
+	}
+
+	// Reshape each input to insert the new size-1 dimension at axis; Reshape is safe here because it
+	// only rewrites the shape metadata, the underlying contiguous data is untouched.
+	expanded := make([]*Tensor, len(tensors))
+	for i, T := range tensors {
+		newShape := make([]int, rank+1)
+		copy(newShape[:axis], T.shape[:axis])
+		newShape[axis] = 1
+		copy(newShape[axis+1:], T.shape[axis:])
+		expanded[i] = T.Reshape(newShape)
+	}
+
+	return ConcatList(expanded, axis)
+}
+
+// Unstack is the inverse of Stack: it slices A into A.shape[axis] tensors of rank len(A.shape)-1,
+// analogous to TensorFlow's unpack. axis must be a valid dimension of A.
+func Unstack(A *Tensor, axis int) []*Tensor {
+	rank := len(A.shape)
+	if axis < 0 || axis >= rank {
+		panic("axis is out of bounds for Unstack()")
+	}
+
+	n := A.shape[axis]
+	outputs := make([]*Tensor, n)
+	for k := 0; k < n; k++ {
+		// Build a slice string that selects index k along axis and the full range elsewhere.
+		parts := make([]string, rank)
+		for d := 0; d < rank; d++ {
+			if d == axis {
+				parts[d] = strconv.Itoa(k) + ":" + strconv.Itoa(k+1)
+			} else {
+				parts[d] = ":"
+			}
+		}
+		sliced := Partial(A, strings.Join(parts, ","))
+
+		// Drop the now size-1 axis dimension to bring the rank back down to rank-1.
+		newShape := make([]int, rank-1)
+		copy(newShape[:axis], sliced.shape[:axis])
+		copy(newShape[axis:], sliced.shape[axis+1:])
+		outputs[k] = sliced.Reshape(newShape)
+	}
+
+	return outputs
+}
+
+// reflect maps v, a signed index that may fall arbitrarily far outside [0, n), back into [0, n)
+// using NumPy's "reflect" convention: the sequence bounces off each boundary without repeating it,
+// i.e. it is periodic with period 2*(n-1). This handles pad amounts of any size, not just amounts
+// smaller than n, by bouncing repeatedly instead of reflecting only once.
+func reflect(v, n int) int {
+	if n == 1 {
+		return 0
+	}
+
+	period := 2 * (n - 1)
+	v %= period
+	if v < 0 {
+		v += period
+	}
+	if v >= n {
+		v = period - v
+	}
+	return v
+}
+
+// Pad returns a new tensor with padding[d] = {before, after} extra elements added before and after
+// axis d, matching the TOSA PAD operator and NumPy's np.pad contract. The output shape is
+// A.shape[d] + padding[d][0] + padding[d][1] for every dimension d. Three modes are supported:
+// "constant" fills padded elements with constant, "edge" replicates the nearest boundary element,
+// and "reflect" mirrors the tensor without repeating the boundary (index -k maps to k), bouncing
+// repeatedly off each edge so pad amounts are not limited to less than the axis size.
+// Example:
+// A := Range_Tensor([]int{3, 4})
+// A_Padded := Pad(A, [][2]int{{1, 1}, {0, 2}}, "edge", 0)
+func Pad(A *Tensor, padding [][2]int, mode string, constant float64) *Tensor {
+	if len(padding) != len(A.shape) {
+		panic("Pad() padding must have the same number of dimensions as the tensor")
+	}
+	for _, p := range padding {
+		if p[0] < 0 || p[1] < 0 {
+			panic("Pad() padding amounts must be non-negative")
+		}
+	}
+
+	// Determine the shape of the padded tensor.
+	paddedShape := make([]int, len(A.shape))
+	for d := range A.shape {
+		paddedShape[d] = A.shape[d] + padding[d][0] + padding[d][1]
+	}
+
+	paddedTensor := Zero_Tensor(paddedShape)
+
+	// Initialize a slice to store the current multi-dimensional index being processed.
+	tempIndex := make([]int, len(paddedShape))
+
+	// Define a recursive function to fill the padded tensor.
+	// The function takes the current dimension as a parameter.
+	var fillPaddedTensor func(int)
+	fillPaddedTensor = func(dim int) {
+		if dim == len(paddedShape) { // <--- This base case is reached for every element in the padded tensor.
+
+			// Calculate the corresponding source index in the original tensor, and whether it falls inside A.
+			srcIndex := make([]int, len(paddedShape))
+			inside := true
+			for i := range paddedShape {
+				srcIndex[i] = tempIndex[i] - padding[i][0]
+				if srcIndex[i] < 0 || srcIndex[i] >= A.shape[i] {
+					inside = false
+				}
+			}
+
+			dstFlattenedIndex := Index(tempIndex, paddedShape)
+
+			if inside {
+				paddedTensor.data[dstFlattenedIndex] = A.data[Index(srcIndex, A.shape)]
+				return
+			}
+
+			// srcIndex falls outside A, so resolve it according to mode.
+			switch mode {
+			case "constant":
+				paddedTensor.data[dstFlattenedIndex] = constant
+			case "edge":
+				clampedIndex := make([]int, len(srcIndex))
+				for i, v := range srcIndex {
+					clampedIndex[i] = v
+					if clampedIndex[i] < 0 {
+						clampedIndex[i] = 0
+					} else if clampedIndex[i] >= A.shape[i] {
+						clampedIndex[i] = A.shape[i] - 1
+					}
+				}
+				paddedTensor.data[dstFlattenedIndex] = A.data[Index(clampedIndex, A.shape)]
+			case "reflect":
+				reflectedIndex := make([]int, len(srcIndex))
+				for i, v := range srcIndex {
+					reflectedIndex[i] = reflect(v, A.shape[i])
+				}
+				paddedTensor.data[dstFlattenedIndex] = A.data[Index(reflectedIndex, A.shape)]
+			default:
+				panic("Pad(): unsupported mode " + mode)
+			}
+
+			return
+		}
+
+		// Recursively process each index in the current dimension.
+		for i := 0; i < paddedShape[dim]; i++ {
+			tempIndex[dim] = i
+			fillPaddedTensor(dim + 1)
+		}
+	}
+
+	// Start the recursive process from the first dimension.
+	fillPaddedTensor(0)
+
+	// Return the filled padded tensor.
+	return paddedTensor
+}
+
+// Split is the inverse of Concat/ConcatList: it divides A along axis into len(sizes) tensors, where
+// the k'th output has the same shape as A except dimension axis is sizes[k], and sum(sizes) must
+// equal A.shape[axis]. It reuses the transpose-to-axis-0 trick from Concat: A is transposed once so
+// axis becomes the 0'th dimension, contiguous chunks of that transposed data are sliced out for each
+// output, and each chunk is transposed back to the original axis ordering.
+func Split(A *Tensor, axis int, sizes []int) []*Tensor {
+	if axis < 0 || axis >= len(A.shape) {
+		panic("axis is out of bounds for Split()")
+	}
+
+	sizeSum := 0
+	for _, size := range sizes {
+		if size <= 0 {
+			panic("Split() sizes must all be positive")
+		}
+		sizeSum += size
+	}
+	if sizeSum != A.shape[axis] {
+		panic("Split() sizes must sum to A.shape[axis]")
+	}
+
+	// determine the reordering of the axes for transpose to make axis the 0'th axis of the slice,
+	// same scheme used by Concat/ConcatList.
+	axes_reordering := make([]int, len(A.shape))
+	axes_reordering[0] = axis
+	for i, count := 1, 0; count < len(A.shape); count++ {
+		if count != axis {
+			axes_reordering[i] = count
+			i++
+		}
+	}
+
+	// Invert axes_reordering so each chunk can be transposed back to A's original axis ordering.
+	inverse_reordering := make([]int, len(axes_reordering))
+	for i, a := range axes_reordering {
+		inverse_reordering[a] = i
+	}
+
+	A_T := A.Transpose(axes_reordering)
+
+	// rowSize is the number of floats in a single slice along the (now 0'th) split axis.
+	rowSize := 1
+	for _, d := range A_T.shape[1:] {
+		rowSize *= d
+	}
+
+	outputs := make([]*Tensor, len(sizes))
+	offset := 0
+	for k, size := range sizes {
+		chunkShape_Transposed := make([]int, len(A_T.shape))
+		copy(chunkShape_Transposed, A_T.shape)
+		chunkShape_Transposed[0] = size
+
+		chunkData := make([]float64, rowSize*size)
+		copy(chunkData, A_T.data[offset*rowSize:(offset+size)*rowSize])
+
+		chunkTensor_Transposed := &Tensor{shape: chunkShape_Transposed, data: chunkData}
+		outputs[k] = chunkTensor_Transposed.Transpose(inverse_reordering)
+
+		offset += size
+	}
+
+	return outputs
+}
+
+// SplitEqual is a convenience wrapper over Split that divides A.shape[axis] into n equal pieces,
+// panicking if A.shape[axis] is not evenly divisible by n.
+func SplitEqual(A *Tensor, axis int, n int) []*Tensor {
+	if n <= 0 {
+		panic("SplitEqual() n must be positive")
+	}
+	if axis < 0 || axis >= len(A.shape) {
+		panic("axis is out of bounds for SplitEqual()")
+	}
+	if A.shape[axis]%n != 0 {
+		panic("SplitEqual() A.shape[axis] must be evenly divisible by n")
+	}
+
+	size := A.shape[axis] / n
+	sizes := make([]int, n)
+	for i := range sizes {
+		sizes[i] = size
+	}
+
+	return Split(A, axis, sizes)
+}
+
+// Squeeze removes size-1 dimensions from A. With no arguments it removes every size-1 dimension; given
+// one or more axes it removes only those, panicking if any listed axis does not have size 1. Since the
+// underlying data is contiguous and unaffected by dropping size-1 dimensions, this is just a Reshape.
+func (A *Tensor) Squeeze(axes ...int) *Tensor {
+	toRemove := make(map[int]bool, len(axes))
+	if len(axes) == 0 {
+		for i, d := range A.shape {
+			if d == 1 {
+				toRemove[i] = true
+			}
+		}
+	} else {
+		for _, axis := range axes {
+			if axis < 0 || axis >= len(A.shape) {
+				panic("axis is out of bounds for Squeeze()")
+			}
+			if A.shape[axis] != 1 {
+				panic("Squeeze(): axis does not have size 1")
+			}
+			toRemove[axis] = true
+		}
+	}
+
+	newShape := make([]int, 0, len(A.shape))
+	for i, d := range A.shape {
+		if !toRemove[i] {
+			newShape = append(newShape, d)
+		}
+	}
+
+	return A.Reshape(newShape)
+}
+
+// ExpandDims inserts a new size-1 dimension at axis (valid in [0, len(A.shape)]). Like Squeeze, this
+// is just a Reshape since it only rewrites shape metadata over the same contiguous data.
+func (A *Tensor) ExpandDims(axis int) *Tensor {
+	if axis < 0 || axis > len(A.shape) {
+		panic("axis is out of bounds for ExpandDims()")
+	}
+
+	newShape := make([]int, len(A.shape)+1)
+	copy(newShape[:axis], A.shape[:axis])
+	newShape[axis] = 1
+	copy(newShape[axis+1:], A.shape[axis:])
+
+	return A.Reshape(newShape)
+}
+
+// PartialReduce behaves like Partial, except that any dimension whose slice spec was a single bare
+// integer index (e.g. "2", parsed as "2:3") is squeezed out of the result, matching MLIR's
+// rank-reduced extract-slice behavior. Dimensions sliced with a range, even a size-1 range like
+// "3:4", are left in place.
+// Example:
+// A := Range_Tensor([]int{3, 4, 9, 2})
+// A_Reduced := PartialReduce(A, "1, 2:, :3, :") // rank 3, the 0'th axis is dropped
+func PartialReduce(A *Tensor, slice string) *Tensor {
+	_, _, isIndex := parseSliceSpec(slice, A.shape)
+
+	axes := make([]int, 0, len(isIndex))
+	for i, reduced := range isIndex {
+		if reduced {
+			axes = append(axes, i)
+		}
+	}
+
+	// Squeeze()'s no-arg form removes every size-1 dimension, which would also drop size-1 ranges
+	// like "3:4" that PartialReduce is documented to leave in place. Only call it when there is at
+	// least one bare-index dimension to drop.
+	if len(axes) == 0 {
+		return Partial(A, slice)
+	}
+
+	return Partial(A, slice).Squeeze(axes...)
+}
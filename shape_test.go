@@ -0,0 +1,26 @@
+package main
+
+// Regression test for a Stack/ConcatList bug where stacking along axis >= 2 scrambled the result
+// because the axis-to-front transpose was undone with the forward permutation instead of its inverse.
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStackHighAxis(t *testing.T) {
+	a := &Tensor{shape: []int{2, 2}, data: []float64{0, 1, 2, 3}}
+	b := &Tensor{shape: []int{2, 2}, data: []float64{10, 11, 12, 13}}
+
+	stacked := Stack([]*Tensor{a, b}, 2)
+
+	wantShape := []int{2, 2, 2}
+	if !reflect.DeepEqual(stacked.shape, wantShape) {
+		t.Fatalf("Stack() shape = %v, want %v", stacked.shape, wantShape)
+	}
+
+	wantData := []float64{0, 10, 1, 11, 2, 12, 3, 13}
+	if !reflect.DeepEqual(stacked.data, wantData) {
+		t.Fatalf("Stack() data = %v, want %v", stacked.data, wantData)
+	}
+}